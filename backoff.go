@@ -0,0 +1,15 @@
+package watchdog
+
+import "time"
+
+// BackOff determines how long to wait between unsuccessful attempts of
+// a Retry.
+type BackOff interface {
+	// Reset clears any internal state so the next call to NextInterval
+	// returns the backoff's initial interval.
+	Reset()
+
+	// NextInterval returns the duration to wait before the next retry
+	// attempt.
+	NextInterval() time.Duration
+}
@@ -0,0 +1,154 @@
+package watchdog
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/efritz/glock"
+)
+
+// Breaker decides whether a Watcher should attempt its underlying
+// RetryE or short-circuit and report a synthetic failure, protecting a
+// struggling dependency from being hammered by a fast retry loop.
+type Breaker interface {
+	// Allow reports whether the next attempt should be let through. It
+	// counts as an observed request whether or not it returns true.
+	Allow() bool
+
+	// Report records the outcome of an attempt that was let through.
+	Report(success bool)
+}
+
+// googleBreaker is a client-side adaptive throttle modeled on the
+// algorithm described in the Google SRE book and popularized by
+// go-zero's googlebreaker: once a dependency's failure rate rises, an
+// increasing fraction of attempts are dropped locally instead of being
+// made and left to fail remotely.
+type googleBreaker struct {
+	k      float64
+	clock  glock.Clock
+	bucket time.Duration
+
+	mutex   sync.Mutex
+	buckets []breakerBucket
+}
+
+type breakerBucket struct {
+	start    time.Time
+	requests int
+	accepts  int
+}
+
+// defaultBreakerWindow and defaultBreakerBuckets are substituted for a
+// non-positive window or bucket count so NewGoogleBreaker never panics
+// on a misconfigured call.
+const (
+	defaultBreakerWindow  = 10 * time.Second
+	defaultBreakerBuckets = 40
+)
+
+// NewGoogleBreaker creates a Breaker that tracks requests and accepts
+// over a rolling window split into buckets, dropping an increasing
+// fraction of attempts as the request-to-accept ratio grows past k. A
+// non-positive window or buckets, or a window too short to split into
+// buckets at least a nanosecond wide, falls back to a 10s window of 40
+// buckets rather than panicking.
+func NewGoogleBreaker(k float64, window time.Duration, buckets int) Breaker {
+	return newGoogleBreakerWithClock(k, window, buckets, glock.NewRealClock())
+}
+
+func newGoogleBreakerWithClock(k float64, window time.Duration, buckets int, clock glock.Clock) *googleBreaker {
+	if window <= 0 || buckets <= 0 || window/time.Duration(buckets) <= 0 {
+		window = defaultBreakerWindow
+		buckets = defaultBreakerBuckets
+	}
+
+	return &googleBreaker{
+		k:       k,
+		clock:   clock,
+		bucket:  window / time.Duration(buckets),
+		buckets: make([]breakerBucket, buckets),
+	}
+}
+
+func (b *googleBreaker) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.roll()
+	requests, accepts := b.totals()
+	b.current().requests++
+
+	dropRatio := math.Max(0, (float64(requests)-b.k*float64(accepts))/float64(requests+1))
+	if dropRatio <= 0 {
+		return true
+	}
+
+	return rand.Float64() >= dropRatio
+}
+
+func (b *googleBreaker) Report(success bool) {
+	if !success {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.roll()
+	b.current().accepts++
+}
+
+// current returns the bucket covering the present moment, which roll
+// guarantees is the last element of b.buckets.
+func (b *googleBreaker) current() *breakerBucket {
+	return &b.buckets[len(b.buckets)-1]
+}
+
+// roll advances the bucket window, discarding buckets that have aged
+// out and zeroing any that have rolled into view for the first time.
+func (b *googleBreaker) roll() {
+	now := b.clock.Now()
+	last := &b.buckets[len(b.buckets)-1]
+
+	if last.start.IsZero() {
+		last.start = now
+		return
+	}
+
+	elapsed := now.Sub(last.start)
+	shift := int(elapsed / b.bucket)
+	if shift <= 0 {
+		return
+	}
+
+	if shift >= len(b.buckets) {
+		shift = len(b.buckets)
+	}
+
+	copy(b.buckets, b.buckets[shift:])
+	for i := len(b.buckets) - shift; i < len(b.buckets); i++ {
+		b.buckets[i] = breakerBucket{}
+	}
+
+	b.buckets[len(b.buckets)-1].start = now
+}
+
+func (b *googleBreaker) totals() (requests, accepts int) {
+	for _, bucket := range b.buckets {
+		requests += bucket.requests
+		accepts += bucket.accepts
+	}
+
+	return requests, accepts
+}
+
+// WithBreaker attaches a circuit breaker that the Watcher consults
+// before invoking the underlying RetryE.
+func WithBreaker(breaker Breaker) Option {
+	return func(w *Watcher) {
+		w.breaker = breaker
+	}
+}
@@ -0,0 +1,74 @@
+package watchdog
+
+import (
+	"time"
+
+	"github.com/aphistic/sweet"
+	"github.com/efritz/glock"
+	. "github.com/onsi/gomega"
+)
+
+type BreakerSuite struct{}
+
+func (s *BreakerSuite) TestAllowsWhenNoHistory(t sweet.T) {
+	clock := glock.NewMockClock()
+	breaker := newGoogleBreakerWithClock(1.5, 10*time.Second, 40, clock)
+
+	Expect(breaker.Allow()).To(BeTrue())
+}
+
+func (s *BreakerSuite) TestDropRateApproachesOneUnderSustainedFailure(t sweet.T) {
+	var (
+		clock   = glock.NewMockClock()
+		breaker = newGoogleBreakerWithClock(1.5, 10*time.Second, 40, clock)
+	)
+
+	allowed := 0
+	total := 2000
+
+	for i := 0; i < total; i++ {
+		if breaker.Allow() {
+			allowed++
+			breaker.Report(false)
+		}
+
+		clock.Advance(time.Millisecond)
+	}
+
+	Expect(float64(allowed) / float64(total)).To(BeNumerically("<", 0.2))
+}
+
+func (s *BreakerSuite) TestNonPositiveWindowAndBucketsDoNotPanic(t sweet.T) {
+	clock := glock.NewMockClock()
+
+	Expect(func() { NewGoogleBreaker(1.5, 0, 0) }).NotTo(Panic())
+	Expect(func() { NewGoogleBreaker(1.5, -time.Second, -5) }).NotTo(Panic())
+
+	breaker := newGoogleBreakerWithClock(1.5, 0, -5, clock)
+	Expect(breaker.Allow()).To(BeTrue())
+}
+
+func (s *BreakerSuite) TestWindowShorterThanBucketsDoesNotPanic(t sweet.T) {
+	clock := glock.NewMockClock()
+
+	Expect(func() { NewGoogleBreaker(1.5, 20*time.Nanosecond, 40) }).NotTo(Panic())
+
+	breaker := newGoogleBreakerWithClock(1.5, 20*time.Nanosecond, 40, clock)
+	Expect(breaker.Allow()).To(BeTrue())
+
+	clock.Advance(time.Second)
+	Expect(func() { breaker.Allow() }).NotTo(Panic())
+}
+
+func (s *BreakerSuite) TestAllowsAllOnSuccess(t sweet.T) {
+	var (
+		clock   = glock.NewMockClock()
+		breaker = newGoogleBreakerWithClock(1.5, 10*time.Second, 40, clock)
+	)
+
+	for i := 0; i < 100; i++ {
+		Expect(breaker.Allow()).To(BeTrue())
+		breaker.Report(true)
+		clock.Advance(time.Millisecond)
+	}
+}
@@ -0,0 +1,144 @@
+package watchdog
+
+import (
+	"context"
+	"sync"
+)
+
+// Event reports a readiness transition for a named member of a Group.
+type Event struct {
+	Name  string
+	Ready bool
+	Err   error
+}
+
+// Group composes multiple named Watchers so a caller can wait for all
+// of them, or the first of them, to become ready.
+type Group struct {
+	members map[string]*Watcher
+
+	mutex    sync.Mutex
+	started  bool
+	ch       chan Event
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewGroup creates a Group from a set of named watchers. The watchers
+// are not started until Start is called.
+func NewGroup(members map[string]*Watcher) *Group {
+	return &Group{
+		members: members,
+		ch:      make(chan Event),
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// Start begins watching every member concurrently and returns a
+// channel that receives an Event every time a member reports readiness
+// or gives up, including re-checks triggered by Check. The channel is
+// closed once every member watcher has stopped.
+func (g *Group) Start() <-chan Event {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.started {
+		return g.ch
+	}
+	g.started = true
+
+	var wg sync.WaitGroup
+	for name, watcher := range g.members {
+		name, watcher := name, watcher
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ch, err := watcher.Start()
+			if err != nil {
+				select {
+				case g.ch <- Event{Name: name, Ready: false, Err: err}:
+				case <-g.stopCh:
+				}
+				return
+			}
+
+			for err := range ch {
+				select {
+				case g.ch <- Event{Name: name, Ready: err == nil, Err: err}:
+				case <-g.stopCh:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(g.ch)
+	}()
+
+	return g.ch
+}
+
+// Check re-arms the named member via Watcher.Check. A member waiting
+// after a success re-checks its readiness immediately; a member still
+// retrying finishes its current backoff interval first and re-checks
+// right after its next success. An unknown name is ignored.
+func (g *Group) Check(name string) {
+	if watcher, ok := g.members[name]; ok {
+		watcher.Check()
+	}
+}
+
+// Stop shuts down every member watcher and releases any forwarding
+// goroutine that is blocked delivering an Event nobody is reading
+// anymore.
+func (g *Group) Stop() {
+	g.stopOnce.Do(func() { close(g.stopCh) })
+
+	for _, watcher := range g.members {
+		watcher.Stop()
+	}
+}
+
+// WaitAll blocks until every member has reported readiness at least
+// once, ctx is cancelled, or any member reports a terminal error.
+func (g *Group) WaitAll(ctx context.Context) error {
+	ch := g.Start()
+	ready := make(map[string]bool, len(g.members))
+
+	for len(ready) < len(g.members) {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if !event.Ready {
+				return event.Err
+			}
+			ready[event.Name] = true
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// WaitAny blocks until the first member reports readiness or a
+// terminal error, or ctx is cancelled.
+func (g *Group) WaitAny(ctx context.Context) (string, error) {
+	ch := g.Start()
+
+	select {
+	case event, ok := <-ch:
+		if !ok {
+			return "", nil
+		}
+		return event.Name, event.Err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
@@ -0,0 +1,157 @@
+package watchdog
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/aphistic/sweet"
+	"github.com/efritz/glock"
+	. "github.com/onsi/gomega"
+
+	"github.com/efritz/watchdog/internal"
+)
+
+type GroupSuite struct{}
+
+func (s *GroupSuite) TestWaitAllBlocksUntilEveryMemberReady(t sweet.T) {
+	var (
+		dbAttempts    = 0
+		cacheAttempts = 0
+		dbClock       = glock.NewMockClock()
+		cacheClock    = glock.NewMockClock()
+	)
+
+	group := NewGroup(map[string]*Watcher{
+		"db": newWatcherWithClock(RetryFunc(func() bool {
+			dbAttempts++
+			return dbAttempts >= 2
+		}), internal.NewMockBackoff(), dbClock),
+		"cache": newWatcherWithClock(RetryFunc(func() bool {
+			cacheAttempts++
+			return cacheAttempts >= 3
+		}), internal.NewMockBackoff(), cacheClock),
+	})
+	defer group.Stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- group.WaitAll(context.Background())
+	}()
+
+	dbClock.BlockingAdvance(time.Second)
+	cacheClock.BlockingAdvance(time.Second)
+	cacheClock.BlockingAdvance(time.Second)
+
+	Eventually(errCh).Should(Receive(BeNil()))
+}
+
+func (s *GroupSuite) TestWaitAnyReturnsFirstReady(t sweet.T) {
+	var (
+		fastAttempts = 0
+		slowAttempts = 0
+		fastClock    = glock.NewMockClock()
+		slowClock    = glock.NewMockClock()
+	)
+
+	group := NewGroup(map[string]*Watcher{
+		"fast": newWatcherWithClock(RetryFunc(func() bool {
+			fastAttempts++
+			return fastAttempts >= 1
+		}), internal.NewMockBackoff(), fastClock),
+		"slow": newWatcherWithClock(RetryFunc(func() bool {
+			slowAttempts++
+			return slowAttempts >= 100
+		}), internal.NewMockBackoff(), slowClock),
+	})
+	defer group.Stop()
+
+	nameCh := make(chan string, 1)
+	go func() {
+		name, err := group.WaitAny(context.Background())
+		Expect(err).To(BeNil())
+		nameCh <- name
+	}()
+
+	Eventually(nameCh).Should(Receive(Equal("fast")))
+}
+
+func (s *GroupSuite) TestCheckTriggersAnotherEvent(t sweet.T) {
+	var (
+		attempts = 0
+		clock    = glock.NewMockClock()
+	)
+
+	group := NewGroup(map[string]*Watcher{
+		"db": newWatcherWithClock(RetryFunc(func() bool {
+			attempts++
+			return (attempts % 2) == 0
+		}), internal.NewMockBackoff(), clock),
+	})
+	defer group.Stop()
+
+	ch := group.Start()
+
+	clock.BlockingAdvance(time.Second)
+	Eventually(ch).Should(Receive(Equal(Event{Name: "db", Ready: true})))
+
+	group.Check("db")
+	clock.BlockingAdvance(time.Second)
+
+	Eventually(ch).Should(Receive(Equal(Event{Name: "db", Ready: true})))
+	Expect(attempts).To(Equal(4))
+}
+
+func (s *GroupSuite) TestStopDrainsMemberGoroutinesWhenMultipleReady(t sweet.T) {
+	before := runtime.NumGoroutine()
+
+	var (
+		fastAttempts = 0
+		slowAttempts = 0
+		fastClock    = glock.NewMockClock()
+		slowClock    = glock.NewMockClock()
+	)
+
+	group := NewGroup(map[string]*Watcher{
+		"fast": newWatcherWithClock(RetryFunc(func() bool {
+			fastAttempts++
+			return fastAttempts >= 1
+		}), internal.NewMockBackoff(), fastClock),
+		"slow": newWatcherWithClock(RetryFunc(func() bool {
+			slowAttempts++
+			return slowAttempts >= 2
+		}), internal.NewMockBackoff(), slowClock),
+	})
+
+	nameCh := make(chan string, 1)
+	go func() {
+		name, _ := group.WaitAny(context.Background())
+		nameCh <- name
+	}()
+
+	Eventually(nameCh).Should(Receive(Equal("fast")))
+
+	// Nothing is reading from the group's channel anymore. Without a
+	// way to abandon the send, the forwarder for "slow" would block on
+	// it forever once "slow" becomes ready.
+	slowClock.BlockingAdvance(time.Second)
+
+	group.Stop()
+
+	Eventually(func() int { return runtime.NumGoroutine() }).Should(BeNumerically("<=", before))
+}
+
+func (s *GroupSuite) TestStopDrainsMemberGoroutinesWithoutLeak(t sweet.T) {
+	before := runtime.NumGoroutine()
+	clock := glock.NewMockClock()
+
+	group := NewGroup(map[string]*Watcher{
+		"db": newWatcherWithClock(RetryFunc(func() bool { return false }), internal.NewMockBackoff(), clock),
+	})
+
+	ch := group.Start()
+	group.Stop()
+
+	Eventually(ch).Should(BeClosed())
+	Eventually(func() int { return runtime.NumGoroutine() }).Should(BeNumerically("<=", before))
+}
@@ -0,0 +1,55 @@
+package watchdog
+
+import "time"
+
+// Hooks are optional callbacks invoked by Watcher at well-defined points
+// in the retry lifecycle, letting operators observe retry behavior
+// without wrapping the underlying RetryE.
+type Hooks struct {
+	// OnAttempt is called immediately before each attempt, starting at 1.
+	OnAttempt func(n int)
+
+	// OnBackoff is called after a failed, retryable attempt with the
+	// duration the watcher will sleep before the next attempt.
+	OnBackoff func(n int, wait time.Duration)
+
+	// OnSuccess is called once the retry succeeds, with the number of
+	// attempts made and the total elapsed time.
+	OnSuccess func(n int, elapsed time.Duration)
+
+	// OnGiveUp is called when the watcher stops without succeeding,
+	// either because it was stopped, a Policy limit was reached, or a
+	// terminal error was returned.
+	OnGiveUp func(reason error)
+}
+
+func (h Hooks) onAttempt(n int) {
+	if h.OnAttempt != nil {
+		h.OnAttempt(n)
+	}
+}
+
+func (h Hooks) onBackoff(n int, wait time.Duration) {
+	if h.OnBackoff != nil {
+		h.OnBackoff(n, wait)
+	}
+}
+
+func (h Hooks) onSuccess(n int, elapsed time.Duration) {
+	if h.OnSuccess != nil {
+		h.OnSuccess(n, elapsed)
+	}
+}
+
+func (h Hooks) onGiveUp(reason error) {
+	if h.OnGiveUp != nil {
+		h.OnGiveUp(reason)
+	}
+}
+
+// WithHooks attaches observability callbacks to a Watcher.
+func WithHooks(hooks Hooks) Option {
+	return func(w *Watcher) {
+		w.hooks = hooks
+	}
+}
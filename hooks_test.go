@@ -0,0 +1,74 @@
+package watchdog
+
+import (
+	"time"
+
+	"github.com/aphistic/sweet"
+	"github.com/efritz/glock"
+	. "github.com/onsi/gomega"
+
+	"github.com/efritz/watchdog/internal"
+)
+
+type HooksSuite struct{}
+
+func (s *HooksSuite) TestHooksFireOnSuccess(t sweet.T) {
+	var (
+		attempts     = 0
+		onAttempts   []int
+		onBackoffs   []int
+		onSuccessN   int
+		onGiveUpErrs []error
+		clock        = glock.NewMockClock()
+	)
+
+	watcher := newWatcherWithClockE(
+		asRetryE(RetryFunc(func() bool {
+			attempts++
+			return attempts >= 4
+		})),
+		internal.NewMockBackoff(),
+		Policy{},
+		clock,
+		WithHooks(Hooks{
+			OnAttempt: func(n int) { onAttempts = append(onAttempts, n) },
+			OnBackoff: func(n int, wait time.Duration) { onBackoffs = append(onBackoffs, n) },
+			OnSuccess: func(n int, elapsed time.Duration) { onSuccessN = n },
+			OnGiveUp:  func(reason error) { onGiveUpErrs = append(onGiveUpErrs, reason) },
+		}),
+	)
+
+	ch, _ := watcher.Start()
+	defer watcher.Stop()
+
+	for i := 1; i < 4; i++ {
+		clock.BlockingAdvance(time.Second)
+	}
+
+	<-ch
+	Expect(onAttempts).To(Equal([]int{1, 2, 3, 4}))
+	Expect(onBackoffs).To(Equal([]int{1, 2, 3}))
+	Expect(onSuccessN).To(Equal(4))
+	Expect(onGiveUpErrs).To(BeEmpty())
+}
+
+func (s *HooksSuite) TestHooksFireOnExhaustion(t sweet.T) {
+	var (
+		onGiveUpErr error
+		clock       = glock.NewMockClock()
+	)
+
+	watcher := newWatcherWithClockE(
+		asRetryE(RetryFunc(func() bool { return false })),
+		internal.NewMockBackoff(),
+		Policy{MaxAttempts: 1},
+		clock,
+		WithHooks(Hooks{OnGiveUp: func(reason error) { onGiveUpErr = reason }}),
+	)
+
+	ch, _ := watcher.Start()
+	defer watcher.Stop()
+
+	Expect(<-ch).To(Equal(ErrExhausted))
+	Expect(onGiveUpErr).To(Equal(ErrExhausted))
+}
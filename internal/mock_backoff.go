@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// MockBackoff is a stub BackOff for use in tests, recording how many
+// times each method was invoked.
+type MockBackoff struct {
+	mutex sync.Mutex
+
+	ResetFunc          func()
+	ResetFuncCallCount int
+
+	NextIntervalFunc          func() time.Duration
+	NextIntervalFuncCallCount int
+}
+
+// NewMockBackoff creates a MockBackoff whose Reset is a no-op and whose
+// NextInterval always returns zero, suitable for driving a Watcher in
+// lockstep with a glock.MockClock.
+func NewMockBackoff() *MockBackoff {
+	return &MockBackoff{
+		ResetFunc:        func() {},
+		NextIntervalFunc: func() time.Duration { return 0 },
+	}
+}
+
+func (m *MockBackoff) Reset() {
+	m.mutex.Lock()
+	m.ResetFuncCallCount++
+	m.mutex.Unlock()
+
+	m.ResetFunc()
+}
+
+func (m *MockBackoff) NextInterval() time.Duration {
+	m.mutex.Lock()
+	m.NextIntervalFuncCallCount++
+	m.mutex.Unlock()
+
+	return m.NextIntervalFunc()
+}
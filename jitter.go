@@ -0,0 +1,134 @@
+package watchdog
+
+import (
+	"math/rand"
+	"time"
+)
+
+// JitterOption configures a jitter BackOff decorator.
+type JitterOption func(*jitterConfig)
+
+type jitterConfig struct {
+	rnd *rand.Rand
+}
+
+// WithRandSource seeds a jitter decorator's random number generator
+// with src instead of the package-level default, allowing deterministic
+// tests.
+func WithRandSource(src rand.Source) JitterOption {
+	return func(c *jitterConfig) {
+		c.rnd = rand.New(src)
+	}
+}
+
+func newJitterConfig(opts []JitterOption) *jitterConfig {
+	c := &jitterConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *jitterConfig) int63n(n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+
+	if c.rnd != nil {
+		return c.rnd.Int63n(n)
+	}
+
+	return rand.Int63n(n)
+}
+
+type fullJitter struct {
+	backoff BackOff
+	config  *jitterConfig
+}
+
+// FullJitter wraps backoff so each interval is a uniformly random
+// duration between zero and the wrapped interval, per the "full
+// jitter" strategy from the AWS architecture blog. It trades a lower
+// average wait for the best spread under thundering-herd conditions.
+func FullJitter(backoff BackOff, opts ...JitterOption) BackOff {
+	return &fullJitter{backoff: backoff, config: newJitterConfig(opts)}
+}
+
+func (j *fullJitter) Reset() {
+	j.backoff.Reset()
+}
+
+func (j *fullJitter) NextInterval() time.Duration {
+	return time.Duration(j.config.int63n(int64(j.backoff.NextInterval())))
+}
+
+type equalJitter struct {
+	backoff BackOff
+	config  *jitterConfig
+}
+
+// EqualJitter wraps backoff so each interval is half the wrapped
+// interval plus a uniformly random duration up to the other half,
+// trading a higher average wait than FullJitter for less variance.
+func EqualJitter(backoff BackOff, opts ...JitterOption) BackOff {
+	return &equalJitter{backoff: backoff, config: newJitterConfig(opts)}
+}
+
+func (j *equalJitter) Reset() {
+	j.backoff.Reset()
+}
+
+func (j *equalJitter) NextInterval() time.Duration {
+	next := int64(j.backoff.NextInterval())
+	half := next / 2
+
+	return time.Duration(half + j.config.int63n(half))
+}
+
+type decorrelatedJitter struct {
+	backoff     BackOff
+	maxInterval time.Duration
+	config      *jitterConfig
+
+	base time.Duration
+	prev time.Duration
+}
+
+// DecorrelatedJitter wraps backoff with the "decorrelated jitter"
+// strategy: each interval is drawn from [base, prev*3), clamped to
+// maxInterval, where base is the wrapped backoff's first interval and
+// prev is the interval returned by the previous call. Reset forgets
+// prev so the next interval is drawn fresh from the wrapped backoff.
+func DecorrelatedJitter(backoff BackOff, maxInterval time.Duration, opts ...JitterOption) BackOff {
+	return &decorrelatedJitter{backoff: backoff, maxInterval: maxInterval, config: newJitterConfig(opts)}
+}
+
+func (j *decorrelatedJitter) Reset() {
+	j.backoff.Reset()
+	j.base = 0
+	j.prev = 0
+}
+
+func (j *decorrelatedJitter) NextInterval() time.Duration {
+	next := j.backoff.NextInterval()
+
+	if j.prev == 0 {
+		j.base = next
+		j.prev = next
+
+		if j.prev > j.maxInterval {
+			j.prev = j.maxInterval
+		}
+
+		return j.prev
+	}
+
+	interval := j.base + time.Duration(j.config.int63n(int64(j.prev)*3-int64(j.base)))
+	if interval > j.maxInterval {
+		interval = j.maxInterval
+	}
+
+	j.prev = interval
+	return interval
+}
@@ -0,0 +1,81 @@
+package watchdog
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/aphistic/sweet"
+	. "github.com/onsi/gomega"
+)
+
+type JitterSuite struct{}
+
+type fixedBackoff struct {
+	interval time.Duration
+	resets   int
+}
+
+func (b *fixedBackoff) Reset() {
+	b.resets++
+}
+
+func (b *fixedBackoff) NextInterval() time.Duration {
+	return b.interval
+}
+
+func (s *JitterSuite) TestFullJitterIsDeterministicWithSeed(t sweet.T) {
+	backoff1 := FullJitter(&fixedBackoff{interval: time.Second}, WithRandSource(rand.NewSource(42)))
+	backoff2 := FullJitter(&fixedBackoff{interval: time.Second}, WithRandSource(rand.NewSource(42)))
+
+	for i := 0; i < 5; i++ {
+		Expect(backoff1.NextInterval()).To(Equal(backoff2.NextInterval()))
+	}
+}
+
+func (s *JitterSuite) TestFullJitterBounded(t sweet.T) {
+	backoff := FullJitter(&fixedBackoff{interval: time.Second}, WithRandSource(rand.NewSource(7)))
+
+	for i := 0; i < 100; i++ {
+		next := backoff.NextInterval()
+		Expect(next).To(BeNumerically(">=", 0))
+		Expect(next).To(BeNumerically("<", time.Second))
+	}
+}
+
+func (s *JitterSuite) TestEqualJitterBounded(t sweet.T) {
+	backoff := EqualJitter(&fixedBackoff{interval: time.Second}, WithRandSource(rand.NewSource(7)))
+
+	for i := 0; i < 100; i++ {
+		next := backoff.NextInterval()
+		Expect(next).To(BeNumerically(">=", 500*time.Millisecond))
+		Expect(next).To(BeNumerically("<", time.Second))
+	}
+}
+
+func (s *JitterSuite) TestDecorrelatedJitterRespectsCap(t sweet.T) {
+	backoff := DecorrelatedJitter(&fixedBackoff{interval: 100 * time.Millisecond}, time.Second, WithRandSource(rand.NewSource(7)))
+
+	for i := 0; i < 100; i++ {
+		next := backoff.NextInterval()
+		Expect(next).To(BeNumerically("<=", time.Second))
+	}
+}
+
+func (s *JitterSuite) TestDecorrelatedJitterClampsFirstInterval(t sweet.T) {
+	backoff := DecorrelatedJitter(&fixedBackoff{interval: 2 * time.Second}, time.Second, WithRandSource(rand.NewSource(7)))
+
+	Expect(backoff.NextInterval()).To(Equal(time.Second))
+}
+
+func (s *JitterSuite) TestDecorrelatedJitterResets(t sweet.T) {
+	fixed := &fixedBackoff{interval: 100 * time.Millisecond}
+	backoff := DecorrelatedJitter(fixed, time.Second, WithRandSource(rand.NewSource(7)))
+
+	first := backoff.NextInterval()
+	backoff.NextInterval()
+
+	backoff.Reset()
+	Expect(fixed.resets).To(Equal(1))
+
+	Expect(backoff.NextInterval()).To(Equal(first))
+}
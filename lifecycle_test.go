@@ -0,0 +1,158 @@
+package watchdog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aphistic/sweet"
+	"github.com/efritz/glock"
+	. "github.com/onsi/gomega"
+
+	"github.com/efritz/watchdog/internal"
+)
+
+type LifecycleSuite struct{}
+
+func (s *LifecycleSuite) TestStartTwiceReturnsErrAlreadyStarted(t sweet.T) {
+	watcher := newWatcherWithClock(RetryFunc(func() bool { return false }), internal.NewMockBackoff(), glock.NewMockClock())
+	defer watcher.Stop()
+
+	_, err := watcher.Start()
+	Expect(err).To(BeNil())
+
+	_, err = watcher.Start()
+	Expect(err).To(Equal(ErrAlreadyStarted))
+}
+
+func (s *LifecycleSuite) TestStartAfterStopReturnsErrAlreadyStopped(t sweet.T) {
+	watcher := newWatcherWithClock(RetryFunc(func() bool { return false }), internal.NewMockBackoff(), glock.NewMockClock())
+
+	_, err := watcher.Start()
+	Expect(err).To(BeNil())
+	Expect(watcher.Stop()).To(BeNil())
+
+	_, err = watcher.Start()
+	Expect(err).To(Equal(ErrAlreadyStopped))
+}
+
+func (s *LifecycleSuite) TestStopTwiceReturnsErrAlreadyStopped(t sweet.T) {
+	watcher := newWatcherWithClock(RetryFunc(func() bool { return false }), internal.NewMockBackoff(), glock.NewMockClock())
+
+	_, err := watcher.Start()
+	Expect(err).To(BeNil())
+	Expect(watcher.Stop()).To(BeNil())
+	Expect(watcher.Stop()).To(Equal(ErrAlreadyStopped))
+}
+
+func (s *LifecycleSuite) TestResetAllowsRestart(t sweet.T) {
+	var (
+		attempts = 0
+		clock    = glock.NewMockClock()
+	)
+
+	watcher := newWatcherWithClock(
+		RetryFunc(func() bool {
+			attempts++
+			return attempts >= 2
+		}),
+		internal.NewMockBackoff(),
+		clock,
+	)
+
+	ch, err := watcher.Start()
+	Expect(err).To(BeNil())
+
+	clock.BlockingAdvance(time.Second)
+	<-ch
+
+	Expect(watcher.Stop()).To(BeNil())
+	watcher.Reset()
+
+	ch, err = watcher.Start()
+	Expect(err).To(BeNil())
+	defer watcher.Stop()
+
+	<-ch
+
+	Expect(attempts).To(Equal(3))
+}
+
+func (s *LifecycleSuite) TestResetWaitsForInFlightAttemptToExit(t sweet.T) {
+	var (
+		attempts = 0
+		clock    = glock.NewMockClock()
+		sync1    = make(chan struct{})
+		sync2    = make(chan struct{})
+	)
+
+	defer close(sync1)
+	defer close(sync2)
+
+	watcher := newWatcherWithClock(
+		RetryFunc(func() bool {
+			attempts++
+			if attempts == 1 {
+				sync1 <- struct{}{}
+				<-sync2
+			}
+			return false
+		}),
+		internal.NewMockBackoff(),
+		clock,
+	)
+
+	_, err := watcher.Start()
+	Expect(err).To(BeNil())
+
+	<-sync1
+	Expect(watcher.Stop()).To(BeNil())
+
+	// The watch goroutine is still blocked inside its first attempt, so
+	// Reset must wait for it to observe the stop and exit before it is
+	// safe to recreate the watcher's channels.
+	resetDone := make(chan struct{})
+	go func() {
+		watcher.Reset()
+		close(resetDone)
+	}()
+
+	Consistently(resetDone, 20*time.Millisecond).ShouldNot(BeClosed())
+
+	sync2 <- struct{}{}
+	Eventually(resetDone).Should(BeClosed())
+}
+
+func (s *LifecycleSuite) TestConcurrentStartIsConsistent(t sweet.T) {
+	watcher := newWatcherWithClock(RetryFunc(func() bool { return false }), internal.NewMockBackoff(), glock.NewMockClock())
+	defer watcher.Stop()
+
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		started  int
+		rejected int
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := watcher.Start()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err == nil {
+				started++
+			} else {
+				Expect(err).To(Equal(ErrAlreadyStarted))
+				rejected++
+			}
+		}()
+	}
+
+	wg.Wait()
+	Expect(started).To(Equal(1))
+	Expect(rejected).To(Equal(9))
+}
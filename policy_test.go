@@ -0,0 +1,89 @@
+package watchdog
+
+import (
+	"context"
+	"time"
+
+	"github.com/aphistic/sweet"
+	"github.com/efritz/glock"
+	. "github.com/onsi/gomega"
+
+	"github.com/efritz/watchdog/internal"
+)
+
+type PolicySuite struct{}
+
+func (s *PolicySuite) TestMaxAttempts(t sweet.T) {
+	var (
+		attempts = 0
+		clock    = glock.NewMockClock()
+	)
+
+	watcher := newWatcherWithClockE(
+		RetryFuncE(func(ctx context.Context) error {
+			attempts++
+			return ErrRetryable
+		}),
+		internal.NewMockBackoff(),
+		Policy{MaxAttempts: 5},
+		clock,
+	)
+
+	ch, _ := watcher.Start()
+	defer watcher.Stop()
+
+	for i := 0; i < 4; i++ {
+		clock.BlockingAdvance(time.Second)
+	}
+
+	Expect(<-ch).To(Equal(ErrExhausted))
+	Expect(attempts).To(Equal(5))
+}
+
+func (s *PolicySuite) TestMaxElapsed(t sweet.T) {
+	var (
+		attempts = 0
+		clock    = glock.NewMockClock()
+	)
+
+	watcher := newWatcherWithClockE(
+		RetryFuncE(func(ctx context.Context) error {
+			attempts++
+			return ErrRetryable
+		}),
+		internal.NewMockBackoff(),
+		Policy{MaxElapsed: 3 * time.Second},
+		clock,
+	)
+
+	ch, _ := watcher.Start()
+	defer watcher.Stop()
+
+	for i := 0; i < 3; i++ {
+		clock.BlockingAdvance(time.Second)
+	}
+
+	Expect(<-ch).To(Equal(ErrExhausted))
+	Expect(attempts).To(Equal(4))
+}
+
+func (s *PolicySuite) TestPerAttemptTimeout(t sweet.T) {
+	var timedOut bool
+
+	retry := RetryFuncE(func(ctx context.Context) error {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return ErrRetryable
+		case <-ctx.Done():
+			timedOut = true
+			return ctx.Err()
+		}
+	})
+
+	watcher := NewWatcherEWithPolicy(retry, internal.NewMockBackoff(), Policy{PerAttemptTimeout: 5 * time.Millisecond})
+	ch, _ := watcher.Start()
+	defer watcher.Stop()
+
+	Expect(<-ch).To(Equal(context.DeadlineExceeded))
+	Expect(timedOut).To(BeTrue())
+}
@@ -0,0 +1,70 @@
+// Package prometheus exports watchdog retry activity as Prometheus
+// metrics.
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/efritz/watchdog"
+)
+
+// PrometheusHooks builds a watchdog.Hooks that records retry activity
+// as Prometheus metrics, registered against registerer.
+func PrometheusHooks(registerer prometheus.Registerer) watchdog.Hooks {
+	attempts := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "watchdog_attempts_total",
+		Help: "Total number of retry attempts made by watchdog watchers.",
+	})
+
+	backoffSeconds := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "watchdog_backoff_seconds",
+		Help: "Backoff durations waited between retry attempts.",
+	})
+
+	successTotal := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "watchdog_success_total",
+		Help: "Total number of retries that eventually succeeded.",
+	})
+
+	giveUpTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "watchdog_giveup_total",
+		Help: "Total number of watchers that gave up, labeled by reason.",
+	}, []string{"reason"})
+
+	registerer.MustRegister(attempts, backoffSeconds, successTotal, giveUpTotal)
+
+	return watchdog.Hooks{
+		OnAttempt: func(n int) {
+			attempts.Inc()
+		},
+		OnBackoff: func(n int, wait time.Duration) {
+			backoffSeconds.Observe(wait.Seconds())
+		},
+		OnSuccess: func(n int, elapsed time.Duration) {
+			successTotal.Inc()
+		},
+		OnGiveUp: func(reason error) {
+			giveUpTotal.WithLabelValues(giveUpReason(reason)).Inc()
+		},
+	}
+}
+
+// giveUpReason maps a watcher's give-up error to a bounded set of label
+// values. Terminal errors returned by a RetryE can carry arbitrary,
+// unbounded messages, so they are never used as a label value directly.
+func giveUpReason(reason error) string {
+	switch {
+	case errors.Is(reason, watchdog.ErrExhausted):
+		return "exhausted"
+	case errors.Is(reason, watchdog.ErrStopped):
+		return "stopped"
+	case errors.Is(reason, context.Canceled), errors.Is(reason, context.DeadlineExceeded):
+		return "canceled"
+	default:
+		return "terminal"
+	}
+}
@@ -0,0 +1,65 @@
+package watchdog
+
+import (
+	"context"
+	"errors"
+)
+
+// Retry is a function that can be called repeatedly until it reports
+// success.
+type Retry interface {
+	// Retry invokes the underlying function and returns true if the
+	// attempt succeeded. A return value of false signals that another
+	// attempt should be made after the next backoff interval.
+	Retry() bool
+}
+
+// RetryFunc converts a plain function into a Retry.
+type RetryFunc func() bool
+
+func (f RetryFunc) Retry() bool {
+	return f()
+}
+
+// ErrRetryable is returned by a RetryE to request another attempt. Any
+// error satisfying IsRetryable is treated the same way; any other
+// non-nil error aborts the watch immediately.
+var ErrRetryable = errors.New("watchdog: retryable error")
+
+// RetryE is the error-carrying counterpart of Retry. It should return
+// nil on success, an error satisfying IsRetryable to request another
+// attempt, or any other error to abort immediately.
+type RetryE interface {
+	Retry(ctx context.Context) error
+}
+
+// RetryFuncE converts a plain function into a RetryE.
+type RetryFuncE func(ctx context.Context) error
+
+func (f RetryFuncE) Retry(ctx context.Context) error {
+	return f(ctx)
+}
+
+// IsRetryable reports whether err, or any error wrapped by it, requests
+// another attempt rather than aborting the watch.
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrRetryable)
+}
+
+// retryAdapter drives a legacy Retry as a RetryE: a false result becomes
+// ErrRetryable and a true result becomes a nil error.
+type retryAdapter struct {
+	retry Retry
+}
+
+func asRetryE(retry Retry) RetryE {
+	return &retryAdapter{retry: retry}
+}
+
+func (a *retryAdapter) Retry(ctx context.Context) error {
+	if a.retry.Retry() {
+		return nil
+	}
+
+	return ErrRetryable
+}
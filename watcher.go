@@ -0,0 +1,309 @@
+package watchdog
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/efritz/glock"
+)
+
+// ErrExhausted is sent on a Watcher's channel when a Policy limit
+// (MaxAttempts or MaxElapsed) is reached before the retry succeeds.
+var ErrExhausted = errors.New("watchdog: retry policy exhausted")
+
+// ErrStopped is the reason reported to Hooks.OnGiveUp when a Watcher is
+// stopped while it still has no result to report.
+var ErrStopped = errors.New("watchdog: watcher stopped")
+
+// ErrAlreadyStarted is returned by Start when the Watcher is already
+// running.
+var ErrAlreadyStarted = errors.New("watchdog: watcher already started")
+
+// ErrAlreadyStopped is returned by Start or Stop when the Watcher has
+// already been stopped.
+var ErrAlreadyStopped = errors.New("watchdog: watcher already stopped")
+
+const (
+	watcherIdle int32 = iota
+	watcherRunning
+	watcherStopped
+)
+
+// Policy bounds how long and how many times a Watcher will retry before
+// giving up with ErrExhausted. The zero value imposes no limits.
+type Policy struct {
+	// MaxAttempts caps the number of attempts made before the watcher
+	// gives up. Zero means unlimited.
+	MaxAttempts int
+
+	// MaxElapsed caps the total wall-clock time spent retrying before
+	// the watcher gives up. Zero means unlimited.
+	MaxElapsed time.Duration
+
+	// PerAttemptTimeout bounds how long a single Retry call is allowed
+	// to run; the context passed to Retry is cancelled after this
+	// duration elapses. Zero means no per-attempt timeout.
+	PerAttemptTimeout time.Duration
+}
+
+// Option configures optional behavior on a Watcher.
+type Option func(*Watcher)
+
+// Watcher repeatedly invokes a RetryE until it succeeds or returns a
+// terminal error, optionally pausing between successes until re-armed
+// via Check.
+type Watcher struct {
+	retry   RetryE
+	backoff BackOff
+	policy  Policy
+	breaker Breaker
+	hooks   Hooks
+	clock   glock.Clock
+	state   int32
+	started bool
+	ch      chan error
+	checkCh chan struct{}
+	stopCh  chan struct{}
+	done    chan struct{}
+}
+
+// NewWatcher creates a Watcher around a legacy boolean Retry.
+func NewWatcher(retry Retry, backoff BackOff, opts ...Option) *Watcher {
+	return NewWatcherE(asRetryE(retry), backoff, opts...)
+}
+
+// NewWatcherE creates a Watcher around an error-carrying RetryE.
+func NewWatcherE(retry RetryE, backoff BackOff, opts ...Option) *Watcher {
+	return NewWatcherEWithPolicy(retry, backoff, Policy{}, opts...)
+}
+
+// NewWatcherWithPolicy creates a Watcher around a legacy boolean Retry
+// that gives up with ErrExhausted once policy's limits are reached.
+func NewWatcherWithPolicy(retry Retry, backoff BackOff, policy Policy, opts ...Option) *Watcher {
+	return NewWatcherEWithPolicy(asRetryE(retry), backoff, policy, opts...)
+}
+
+// NewWatcherEWithPolicy creates a Watcher around an error-carrying
+// RetryE that gives up with ErrExhausted once policy's limits are
+// reached.
+func NewWatcherEWithPolicy(retry RetryE, backoff BackOff, policy Policy, opts ...Option) *Watcher {
+	return newWatcherWithClockE(retry, backoff, policy, glock.NewRealClock(), opts...)
+}
+
+func newWatcherWithClock(retry Retry, backoff BackOff, clock glock.Clock) *Watcher {
+	return newWatcherWithClockE(asRetryE(retry), backoff, Policy{}, clock)
+}
+
+func newWatcherWithClockE(retry RetryE, backoff BackOff, policy Policy, clock glock.Clock, opts ...Option) *Watcher {
+	w := &Watcher{
+		retry:   retry,
+		backoff: backoff,
+		policy:  policy,
+		clock:   clock,
+		ch:      make(chan error),
+		checkCh: make(chan struct{}, 1),
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// Start begins watching in a new goroutine. The returned channel
+// receives the outcome of the watch each time the retry succeeds (nil)
+// or terminates with an error, and is closed once the watcher is
+// stopped. It returns ErrAlreadyStarted if the watcher is already
+// running, or ErrAlreadyStopped if it has already been stopped.
+func (w *Watcher) Start() (<-chan error, error) {
+	if !atomic.CompareAndSwapInt32(&w.state, watcherIdle, watcherRunning) {
+		if atomic.LoadInt32(&w.state) == watcherRunning {
+			return nil, ErrAlreadyStarted
+		}
+		return nil, ErrAlreadyStopped
+	}
+
+	w.started = true
+	go w.watch()
+	return w.ch, nil
+}
+
+// Stop shuts down the watcher and closes the channel returned by Start.
+// It returns ErrAlreadyStopped if the watcher has already been stopped.
+func (w *Watcher) Stop() error {
+	if atomic.SwapInt32(&w.state, watcherStopped) == watcherStopped {
+		return ErrAlreadyStopped
+	}
+
+	close(w.stopCh)
+	return nil
+}
+
+// Reset transitions a stopped Watcher back to a fresh, idle state so it
+// can be started again with Start. If the watcher was previously
+// started, Reset blocks until its watch goroutine has fully exited
+// before recreating its channels, so it is safe to call immediately
+// after Stop. It must not be called concurrently with Start or Stop.
+func (w *Watcher) Reset() {
+	if w.started {
+		<-w.done
+	}
+
+	w.started = false
+	w.ch = make(chan error)
+	w.checkCh = make(chan struct{}, 1)
+	w.stopCh = make(chan struct{})
+	w.done = make(chan struct{})
+	atomic.StoreInt32(&w.state, watcherIdle)
+}
+
+// Check re-arms a watcher that is waiting after a successful retry so
+// that it resumes watching immediately.
+func (w *Watcher) Check() {
+	select {
+	case w.checkCh <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) watch() {
+	defer close(w.done)
+	defer close(w.ch)
+
+	cycleStart := w.clock.Now()
+	attempts := 0
+
+	for {
+		w.backoff.Reset()
+
+		attempts++
+		w.hooks.onAttempt(attempts)
+		err := w.attempt()
+
+		for err != nil && IsRetryable(err) {
+			if w.exhausted(attempts, cycleStart) {
+				w.hooks.onGiveUp(ErrExhausted)
+
+				select {
+				case w.ch <- ErrExhausted:
+				case <-w.stopCh:
+				}
+				return
+			}
+
+			wait := w.backoff.NextInterval()
+			w.hooks.onBackoff(attempts, wait)
+
+			select {
+			case <-w.clock.After(wait):
+			case <-w.stopCh:
+				w.hooks.onGiveUp(ErrStopped)
+				return
+			}
+
+			attempts++
+			w.hooks.onAttempt(attempts)
+			err = w.attempt()
+		}
+
+		if err != nil {
+			w.hooks.onGiveUp(err)
+
+			select {
+			case w.ch <- err:
+			case <-w.stopCh:
+			}
+			return
+		}
+
+		w.hooks.onSuccess(attempts, w.clock.Now().Sub(cycleStart))
+
+		select {
+		case w.ch <- nil:
+		case <-w.stopCh:
+			return
+		}
+
+		cycleStart = w.clock.Now()
+		attempts = 0
+
+		select {
+		case <-w.checkCh:
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+func (w *Watcher) exhausted(attempts int, start time.Time) bool {
+	if w.policy.MaxAttempts > 0 && attempts >= w.policy.MaxAttempts {
+		return true
+	}
+
+	if w.policy.MaxElapsed > 0 && w.clock.Now().Sub(start) >= w.policy.MaxElapsed {
+		return true
+	}
+
+	return false
+}
+
+func (w *Watcher) attempt() error {
+	if w.breaker != nil && !w.breaker.Allow() {
+		return ErrRetryable
+	}
+
+	ctx := context.Background()
+
+	if w.policy.PerAttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.policy.PerAttemptTimeout)
+		defer cancel()
+	}
+
+	err := w.retry.Retry(ctx)
+
+	if w.breaker != nil {
+		w.breaker.Report(err == nil)
+	}
+
+	return err
+}
+
+// BlockUntilSuccess blocks until retry succeeds or ctx is cancelled,
+// returning true in the former case and false in the latter.
+func BlockUntilSuccess(ctx context.Context, retry Retry, backoff BackOff) bool {
+	return BlockUntilSuccessE(ctx, asRetryE(retry), backoff) == nil
+}
+
+// BlockUntilSuccessE blocks until retry succeeds, ctx is cancelled, or a
+// terminal (non-retryable) error is returned, whichever happens first.
+// It returns nil, ctx.Err(), or the terminal error respectively.
+func BlockUntilSuccessE(ctx context.Context, retry RetryE, backoff BackOff) error {
+	return BlockUntilSuccessEWithPolicy(ctx, retry, backoff, Policy{})
+}
+
+// BlockUntilSuccessWithPolicy behaves like BlockUntilSuccess but gives
+// up with ErrExhausted once policy's limits are reached.
+func BlockUntilSuccessWithPolicy(ctx context.Context, retry Retry, backoff BackOff, policy Policy) error {
+	return BlockUntilSuccessEWithPolicy(ctx, asRetryE(retry), backoff, policy)
+}
+
+// BlockUntilSuccessEWithPolicy behaves like BlockUntilSuccessE but gives
+// up with ErrExhausted once policy's limits are reached.
+func BlockUntilSuccessEWithPolicy(ctx context.Context, retry RetryE, backoff BackOff, policy Policy) error {
+	watcher := NewWatcherEWithPolicy(retry, backoff, policy)
+	ch, _ := watcher.Start()
+	defer watcher.Stop()
+
+	select {
+	case err := <-ch:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -21,6 +21,12 @@ func TestMain(m *testing.M) {
 	sweet.Run(m, func(s *sweet.S) {
 		s.RegisterPlugin(junit.NewPlugin())
 		s.AddSuite(&WatcherSuite{})
+		s.AddSuite(&PolicySuite{})
+		s.AddSuite(&BreakerSuite{})
+		s.AddSuite(&HooksSuite{})
+		s.AddSuite(&JitterSuite{})
+		s.AddSuite(&GroupSuite{})
+		s.AddSuite(&LifecycleSuite{})
 	})
 }
 
@@ -70,7 +76,7 @@ func (s *WatcherSuite) TestSuccess(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 	defer watcher.Stop()
 
 	for i := 1; i < 20; i++ {
@@ -96,7 +102,7 @@ func (s *WatcherSuite) TestWatcherRespectsBackoff(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 	defer watcher.Stop()
 
 	for i := 1; i < 4; i++ {
@@ -132,7 +138,7 @@ func (s *WatcherSuite) TestStop(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 
 	for i := 1; i < 200; i++ {
 		clock.BlockingAdvance(time.Second)
@@ -162,7 +168,7 @@ func (s *WatcherSuite) TestCheck(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 	defer watcher.Stop()
 
 	for i := 1; i < 20; i++ {
@@ -214,7 +220,7 @@ func (s *WatcherSuite) TestCheckDoesNotResetBackoffDuringWatch(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 
 	for i := 1; i < 200; i++ {
 		watcher.Check()
@@ -252,7 +258,7 @@ func (s *WatcherSuite) TestCheckResetsBackoffAfterSuccess(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 	defer watcher.Stop()
 
 	for i := 1; i < 20; i++ {
@@ -297,7 +303,7 @@ func (s *WatcherSuite) TestCheckDoesNotInterruptIntervalDuringWatch(t sweet.T) {
 		clock,
 	)
 
-	ch := watcher.Start()
+	ch, _ := watcher.Start()
 	defer watcher.Stop()
 
 	for i := 1; i < 20; i++ {